@@ -2,9 +2,14 @@
 package main
 
 import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+
 	"github.com/rancher/fleet/modules/cli/cmds"
+	"github.com/rancher/fleet/modules/cli/pkg/exitcode"
 
-	command "github.com/rancher/wrangler-cli"
+	"github.com/rancher/wrangler/pkg/signals"
 
 	// Ensure GVKs are registered
 	_ "github.com/rancher/fleet/pkg/generated/controllers/fleet.cattle.io"
@@ -18,5 +23,9 @@ import (
 )
 
 func main() {
-	command.Main(cmds.App())
+	ctx := signals.SetupSignalContext()
+	if err := cmds.App().ExecuteContext(ctx); err != nil {
+		logrus.Error(err)
+		os.Exit(exitcode.Code(err))
+	}
 }
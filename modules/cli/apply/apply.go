@@ -15,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/rancher/fleet/modules/cli/pkg/client"
+	"github.com/rancher/fleet/modules/cli/pkg/exitcode"
 	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	"github.com/rancher/fleet/pkg/bundlereader"
 	"github.com/rancher/fleet/pkg/fleetyaml"
@@ -48,6 +49,39 @@ type Options struct {
 	Labels          map[string]string
 	SyncGeneration  int64
 	Auth            bundlereader.Auth
+	// Result, if set, records every processed bundle
+	Result *Result
+	// Incremental skips bundles, which did not change since the commit
+	// they were last applied from
+	Incremental bool
+
+	applyOptionsHash string
+}
+
+// Result lists the bundles processed by Apply, it is used for the
+// machine-readable output of the CLI.
+type Result struct {
+	Bundles []BundleResult `json:"bundles"`
+}
+
+type BundleResult struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Path      string `json:"path"`
+	// Action is one of "created", "updated", "unchanged" or "rendered"
+	Action string `json:"action"`
+}
+
+func (r *Result) add(bundle *fleet.Bundle, path, action string) {
+	if r == nil {
+		return
+	}
+	r.Bundles = append(r.Bundles, BundleResult{
+		Name:      bundle.Name,
+		Namespace: bundle.Namespace,
+		Path:      path,
+		Action:    action,
+	})
 }
 
 func globDirs(baseDir string) (result []string, err error) {
@@ -78,12 +112,20 @@ func Apply(ctx context.Context, client *client.Getter, repoName string, baseDirs
 		baseDirs = []string{"."}
 	}
 
+	if opts.Incremental && opts.Output == nil {
+		hash, err := optionsHash(opts)
+		if err != nil {
+			return err
+		}
+		opts.applyOptionsHash = hash
+	}
+
 	foundBundle := false
 	gitRepoBundlesMap := make(map[string]bool)
 	for i, baseDir := range baseDirs {
 		matches, err := globDirs(baseDir)
 		if err != nil {
-			return fmt.Errorf("invalid path glob %s: %w", baseDir, err)
+			return exitcode.Validationf("invalid path glob %s: %w", baseDir, err)
 		}
 		for _, baseDir := range matches {
 			if i > 0 && opts.Output != nil {
@@ -111,7 +153,10 @@ func Apply(ctx context.Context, client *client.Getter, repoName string, baseDirs
 
 				return nil
 			})
-			if err != nil {
+			if err != nil && foundBundle && opts.Output == nil {
+				// some bundles have already been created or updated
+				return exitcode.Wrap(exitcode.PartialFailure, err)
+			} else if err != nil {
 				return err
 			}
 		}
@@ -125,7 +170,7 @@ func Apply(ctx context.Context, client *client.Getter, repoName string, baseDirs
 	}
 
 	if !foundBundle {
-		return fmt.Errorf("no resource found at the following paths to deploy: %v", baseDirs)
+		return exitcode.Validationf("no resource found at the following paths to deploy: %v", baseDirs)
 	}
 
 	return nil
@@ -228,6 +273,15 @@ func Dir(ctx context.Context, client *client.Getter, name, baseDir string, opts
 	if opts == nil {
 		opts = &Options{}
 	}
+
+	if opts.applyOptionsHash != "" {
+		if bundleName, ok := unchangedBundle(client, name, baseDir, opts); ok {
+			logrus.Infof("unchanged: %s/%s", client.Namespace, bundleName)
+			gitRepoBundlesMap[bundleName] = true
+			opts.Result.add(&fleet.Bundle{ObjectMeta: metav1.ObjectMeta{Name: bundleName, Namespace: client.Namespace}}, baseDir, "unchanged")
+			return nil
+		}
+	}
 	bundle, scans, err := readBundle(ctx, createName(name, baseDir), baseDir, opts)
 	if err != nil {
 		return err
@@ -235,6 +289,12 @@ func Dir(ctx context.Context, client *client.Getter, name, baseDir string, opts
 
 	def := bundle.DeepCopy()
 	def.Namespace = client.Namespace
+	if opts.applyOptionsHash != "" {
+		if def.Annotations == nil {
+			def.Annotations = map[string]string{}
+		}
+		def.Annotations[OptionsHashAnnotation] = opts.applyOptionsHash
+	}
 
 	if len(def.Spec.Resources) == 0 {
 		return ErrNoResources
@@ -251,35 +311,44 @@ func Dir(ctx context.Context, client *client.Getter, name, baseDir string, opts
 		return err
 	}
 
+	action := "rendered"
 	if opts.Output == nil {
-		err = save(client, def, scans...)
+		action, err = save(client, def, scans...)
 	} else {
 		_, err = opts.Output.Write(b)
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	opts.Result.add(def, baseDir, action)
+	return nil
 }
 
-func save(client *client.Getter, bundle *fleet.Bundle, imageScans ...*fleet.ImageScan) error {
+// save creates or updates the bundle and its image scans, it returns
+// whether the bundle was "created" or "updated".
+func save(client *client.Getter, bundle *fleet.Bundle, imageScans ...*fleet.ImageScan) (string, error) {
 	c, err := client.Get()
 	if err != nil {
-		return err
+		return "", err
 	}
 
+	action := "updated"
 	obj, err := c.Fleet.Bundle().Get(bundle.Namespace, bundle.Name, metav1.GetOptions{})
 	if apierrors.IsNotFound(err) {
 		if _, err = c.Fleet.Bundle().Create(bundle); err != nil {
-			return err
+			return "", err
 		}
+		action = "created"
 		logrus.Infof("created: %s/%s", bundle.Namespace, bundle.Name)
 	} else if err != nil {
-		return err
+		return "", err
 	} else {
 		obj.Spec = bundle.Spec
 		obj.Annotations = mergeMap(obj.Annotations, bundle.Annotations)
 		obj.Labels = mergeMap(obj.Labels, bundle.Labels)
 		if _, err := c.Fleet.Bundle().Update(obj); err != nil {
-			return err
+			return "", err
 		}
 		logrus.Infof("updated: %s/%s", obj.Namespace, obj.Name)
 	}
@@ -290,22 +359,22 @@ func save(client *client.Getter, bundle *fleet.Bundle, imageScans ...*fleet.Imag
 		obj, err := c.Fleet.ImageScan().Get(scan.Namespace, scan.Name, metav1.GetOptions{})
 		if apierrors.IsNotFound(err) {
 			if _, err = c.Fleet.ImageScan().Create(scan); err != nil {
-				return err
+				return "", err
 			}
 			logrus.Infof("created (scan): %s/%s", bundle.Namespace, bundle.Name)
 		} else if err != nil {
-			return err
+			return "", err
 		} else {
 			obj.Spec = scan.Spec
 			obj.Annotations = mergeMap(obj.Annotations, bundle.Annotations)
 			obj.Labels = mergeMap(obj.Labels, bundle.Labels)
 			if _, err := c.Fleet.ImageScan().Update(obj); err != nil {
-				return err
+				return "", err
 			}
 			logrus.Infof("updated (scan): %s/%s", obj.Namespace, obj.Name)
 		}
 	}
-	return err
+	return action, err
 }
 
 func mergeMap(a, b map[string]string) map[string]string {
@@ -0,0 +1,128 @@
+package apply
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/rancher/fleet/modules/cli/pkg/client"
+	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	"github.com/rancher/fleet/pkg/bundlereader"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OptionsHashAnnotation stores a hash of the apply options, which are not
+// part of the git repository, e.g. the targets from the GitRepo.
+const OptionsHashAnnotation = "fleet.cattle.io/apply-options-hash"
+
+// optionsHash hashes the options which influence the bundle, but are not
+// part of the git repository.
+func optionsHash(opts *Options) (string, error) {
+	labels := map[string]string{}
+	for k, v := range opts.Labels {
+		if k != fleet.CommitLabel {
+			labels[k] = v
+		}
+	}
+
+	var targets []byte
+	if opts.TargetsFile != "" {
+		data, err := os.ReadFile(opts.TargetsFile)
+		if err != nil {
+			return "", err
+		}
+		targets = data
+	}
+
+	data, err := json.Marshal(struct {
+		Labels          map[string]string
+		Targets         []byte
+		ServiceAccount  string
+		TargetNamespace string
+		Paused          bool
+		Compress        bool
+		SyncGeneration  int64
+	}{
+		Labels:          labels,
+		Targets:         targets,
+		ServiceAccount:  opts.ServiceAccount,
+		TargetNamespace: opts.TargetNamespace,
+		Paused:          opts.Paused,
+		Compress:        opts.Compress,
+		SyncGeneration:  opts.SyncGeneration,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// unchangedBundle returns the name of the existing bundle for baseDir, if none
+// of the bundle's local paths changed between the commit the bundle was
+// created from and the current commit. Any error results in a rebuild.
+func unchangedBundle(client *client.Getter, repoName, baseDir string, opts *Options) (string, bool) {
+	commit := opts.Labels[fleet.CommitLabel]
+	if commit == "" || opts.BundleFile != "" || opts.BundleReader != nil {
+		return "", false
+	}
+
+	name, paths, err := bundlereader.ReadLocalDependencies(baseDir)
+	if err != nil {
+		return "", false
+	}
+	if name == "" {
+		name = createName(repoName, baseDir)
+	}
+
+	c, err := client.Get()
+	if err != nil {
+		return "", false
+	}
+	bundle, err := c.Fleet.Bundle().Get(client.Namespace, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", false
+	} else if err != nil {
+		logrus.Debugf("%s: failed to look up existing bundle, rebuilding: %v", baseDir, err)
+		return "", false
+	}
+
+	previous := bundle.Labels[fleet.CommitLabel]
+	if previous == "" || bundle.Annotations[OptionsHashAnnotation] != opts.applyOptionsHash {
+		return "", false
+	}
+	if previous == commit {
+		return name, true
+	}
+
+	changed, err := pathsChanged(previous, commit, paths)
+	if err != nil {
+		logrus.Debugf("%s: failed to diff %s..%s, rebuilding: %v", baseDir, previous, commit, err)
+		return "", false
+	}
+
+	return name, !changed
+}
+
+// pathsChanged runs git diff between both commits, restricted to paths
+func pathsChanged(from, to string, paths []string) (bool, error) {
+	args := append([]string{"diff", "--quiet", from, to, "--"}, paths...)
+	err := exec.Command("git", args...).Run()
+	if err == nil {
+		return false, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return true, nil
+	}
+	// e.g. the previous commit is not part of a shallow clone
+	return true, err
+}
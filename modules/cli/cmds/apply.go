@@ -2,7 +2,6 @@ package cmds
 
 import (
 	"bytes"
-	"fmt"
 	"os"
 	"os/exec"
 	"strings"
@@ -10,7 +9,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rancher/fleet/modules/cli/apply"
+	"github.com/rancher/fleet/modules/cli/pkg/exitcode"
+	"github.com/rancher/fleet/modules/cli/pkg/output"
 	"github.com/rancher/fleet/modules/cli/pkg/writer"
+	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	command "github.com/rancher/wrangler-cli"
 )
 
@@ -26,6 +28,7 @@ func NewApply() *cobra.Command {
 type Apply struct {
 	BundleInputArgs
 	OutputArgsNoDefault
+	FormatArgs
 	Label             map[string]string `usage:"Labels to apply to created bundles" short:"l"`
 	TargetsFile       string            `usage:"Addition source of targets and restrictions to be append"`
 	Compress          bool              `usage:"Force all resources to be compress" short:"c"`
@@ -38,9 +41,17 @@ type Apply struct {
 	PasswordFile      string            `usage:"Path of file containing basic auth password for helm repo"`
 	CACertsFile       string            `usage:"Path of custom cacerts for helm repo" name:"cacerts-file"`
 	SSHPrivateKeyFile string            `usage:"Path of ssh-private-key for helm repo" name:"ssh-privatekey-file"`
+	Incremental       bool              `usage:"Skip bundles whose paths did not change since the commit they were last applied from"`
 }
 
 func (a *Apply) Run(cmd *cobra.Command, args []string) error {
+	if err := output.Validate(a.Format); err != nil {
+		return err
+	}
+	if a.Format != "" && a.Output == "-" {
+		return exitcode.Validationf("--format can't be combined with writing bundles to stdout")
+	}
+
 	labels := a.Label
 	if a.Commit == "" {
 		a.Commit = currentCommit()
@@ -49,7 +60,7 @@ func (a *Apply) Run(cmd *cobra.Command, args []string) error {
 		if labels == nil {
 			labels = map[string]string{}
 		}
-		labels["fleet.cattle.io/commit"] = a.Commit
+		labels[fleet.CommitLabel] = a.Commit
 	}
 
 	name := ""
@@ -58,11 +69,13 @@ func (a *Apply) Run(cmd *cobra.Command, args []string) error {
 		Output:          writer.NewDefaultNone(a.Output),
 		Compress:        a.Compress,
 		ServiceAccount:  a.ServiceAccount,
-		Labels:          a.Label,
+		Labels:          labels,
 		TargetsFile:     a.TargetsFile,
 		TargetNamespace: a.TargetNamespace,
 		Paused:          a.Paused,
 		SyncGeneration:  int64(a.SyncGeneration),
+		Result:          &apply.Result{},
+		Incremental:     a.Incremental,
 	}
 
 	if a.Username != "" && a.PasswordFile != "" {
@@ -92,7 +105,7 @@ func (a *Apply) Run(cmd *cobra.Command, args []string) error {
 	if a.File == "-" {
 		opts.BundleReader = os.Stdin
 		if len(args) != 1 {
-			return fmt.Errorf("the bundle name is required as the first argument")
+			return exitcode.Validationf("the bundle name is required as the first argument")
 		}
 		name = args[0]
 	} else if a.File != "" {
@@ -103,17 +116,21 @@ func (a *Apply) Run(cmd *cobra.Command, args []string) error {
 		defer f.Close()
 		opts.BundleReader = f
 		if len(args) != 1 {
-			return fmt.Errorf("the bundle name is required as the first argument")
+			return exitcode.Validationf("the bundle name is required as the first argument")
 		}
 		name = args[0]
 	} else if len(args) < 1 {
-		return fmt.Errorf("at least one arguments is required BUNDLE_NAME")
+		return exitcode.Validationf("at least one arguments is required BUNDLE_NAME")
 	} else {
 		name = args[0]
 		args = args[1:]
 	}
 
-	return apply.Apply(cmd.Context(), Client, name, args, opts)
+	err := apply.Apply(cmd.Context(), Client, name, args, opts)
+	if perr := output.Print(os.Stdout, a.Format, opts.Result); perr != nil && err == nil {
+		err = perr
+	}
+	return err
 }
 
 func currentCommit() string {
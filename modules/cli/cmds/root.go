@@ -10,6 +10,13 @@ import (
 	command "github.com/rancher/wrangler-cli"
 )
 
+const exitCodesHelp = `Exit codes:
+  0  success
+  1  error
+  2  partial failure, some bundles were applied before an error occurred
+  3  timeout
+  4  validation error, e.g. invalid arguments or input files`
+
 var (
 	Client          *client.Getter
 	SystemNamespace string
@@ -18,6 +25,7 @@ var (
 
 func App() *cobra.Command {
 	root := command.Command(&Fleet{}, cobra.Command{
+		Long:          "Fleet CLI\n\n" + exitCodesHelp,
 		Version:       version.FriendlyVersion(),
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -62,3 +70,7 @@ type OutputArgs struct {
 type OutputArgsNoDefault struct {
 	Output string `usage:"Output contents to file or - for stdout"  short:"o"`
 }
+
+type FormatArgs struct {
+	Format string `usage:"Print a machine-readable result to stdout, json or yaml"`
+}
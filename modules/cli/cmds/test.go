@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rancher/fleet/modules/cli/match"
+	"github.com/rancher/fleet/modules/cli/pkg/output"
 	command "github.com/rancher/wrangler-cli"
 )
 
@@ -20,6 +21,7 @@ func NewTest() *cobra.Command {
 
 type Test struct {
 	BundleInputArgs
+	FormatArgs
 	Quiet      bool              `usage:"Just print the match and don't print the resources" short:"q"`
 	Group      string            `usage:"Cluster group to match against" short:"g"`
 	Name       string            `usage:"Cluster name to match against" short:"N"`
@@ -29,6 +31,10 @@ type Test struct {
 }
 
 func (m *Test) Run(cmd *cobra.Command, args []string) error {
+	if err := output.Validate(m.Format); err != nil {
+		return err
+	}
+
 	baseDir := "."
 	if len(args) > 0 {
 		baseDir = args[0]
@@ -44,9 +50,11 @@ func (m *Test) Run(cmd *cobra.Command, args []string) error {
 		ClusterLabels:      m.Label,
 		ClusterGroupLabels: m.GroupLabel,
 		Target:             m.Target,
+		Result:             &match.Result{},
 	}
 
-	if m.Quiet {
+	// the structured result replaces the rendered resources on stdout
+	if m.Quiet || m.Format != "" {
 		opts.Output = nil
 	}
 
@@ -57,5 +65,12 @@ func (m *Test) Run(cmd *cobra.Command, args []string) error {
 		opts.ClusterGroup = "default"
 	}
 
-	return match.Match(cmd.Context(), opts)
+	err := match.Match(cmd.Context(), opts)
+	if err != nil && err != match.ErrNoMatch {
+		return err
+	}
+	if perr := output.Print(os.Stdout, m.Format, opts.Result); perr != nil {
+		return perr
+	}
+	return err
 }
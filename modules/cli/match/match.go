@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/rancher/fleet/modules/cli/pkg/exitcode"
 	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	"github.com/rancher/fleet/pkg/bundlematcher"
 	"github.com/rancher/fleet/pkg/bundlereader"
@@ -22,6 +23,8 @@ import (
 	"github.com/rancher/wrangler/pkg/yaml"
 )
 
+var ErrNoMatch = errors.New("no match found")
+
 type Options struct {
 	Output             io.Writer
 	BaseDir            string
@@ -32,6 +35,15 @@ type Options struct {
 	ClusterLabels      map[string]string
 	ClusterGroupLabels map[string]string
 	Target             string
+	// Result, if set, receives the outcome of the match
+	Result *Result
+}
+
+// Result is the machine-readable outcome of Match.
+type Result struct {
+	Bundle  string `json:"bundle"`
+	Matched bool   `json:"matched"`
+	Target  string `json:"target,omitempty"`
 }
 
 func Match(ctx context.Context, opts *Options) error {
@@ -57,28 +69,38 @@ func Match(ctx context.Context, opts *Options) error {
 
 		bundle = &fleet.Bundle{}
 		if err := yaml.Unmarshal(data, bundle); err != nil {
-			return err
+			return exitcode.Wrap(exitcode.ValidationError, err)
 		}
 	}
 
 	bm, err := bundlematcher.New(bundle)
 	if err != nil {
-		return err
+		return exitcode.Wrap(exitcode.ValidationError, err)
 	}
 
+	var target *fleet.BundleTarget
 	if opts.Target == "" {
-		m := bm.Match(opts.ClusterName, map[string]map[string]string{
+		target = bm.Match(opts.ClusterName, map[string]map[string]string{
 			opts.ClusterGroup: opts.ClusterGroupLabels,
 		}, opts.ClusterLabels)
-		return printMatch(bundle, m, opts.Output)
+	} else {
+		target = bm.MatchForTarget(opts.Target)
+	}
+
+	if opts.Result != nil {
+		opts.Result.Bundle = bundle.Name
+		opts.Result.Matched = target != nil
+		if target != nil {
+			opts.Result.Target = target.Name
+		}
 	}
 
-	return printMatch(bundle, bm.MatchForTarget(opts.Target), opts.Output)
+	return printMatch(bundle, target, opts.Output)
 }
 
 func printMatch(bundle *fleet.Bundle, target *fleet.BundleTarget, output io.Writer) error {
 	if target == nil {
-		return errors.New("no match found")
+		return ErrNoMatch
 	}
 	fmt.Fprintf(os.Stderr, "# Matched: %s\n", target.Name)
 	if output == nil {
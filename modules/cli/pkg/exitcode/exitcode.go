@@ -0,0 +1,65 @@
+// Package exitcode defines the documented exit codes of the fleet CLI. (fleetapply)
+//
+// Automation should rely on these codes instead of parsing log output.
+package exitcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	// Success is returned when the command completed without errors.
+	Success = 0
+	// Failure is returned for any error not covered by a more specific code.
+	Failure = 1
+	// PartialFailure is returned when some, but not all, items were processed.
+	PartialFailure = 2
+	// Timeout is returned when the command ran into a deadline.
+	Timeout = 3
+	// ValidationError is returned for invalid arguments or invalid input files.
+	ValidationError = 4
+)
+
+// Error wraps an error with the exit code the CLI should terminate with.
+type Error struct {
+	Code int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap annotates err with code, it returns nil if err is nil.
+func Wrap(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// Validationf returns a new error with the ValidationError exit code.
+func Validationf(format string, args ...interface{}) error {
+	return Wrap(ValidationError, fmt.Errorf(format, args...))
+}
+
+// Code returns the exit code for err.
+func Code(err error) int {
+	if err == nil {
+		return Success
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Timeout
+	}
+	return Failure
+}
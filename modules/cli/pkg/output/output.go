@@ -0,0 +1,49 @@
+// Package output prints machine-readable command results. (fleetapply)
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/rancher/fleet/modules/cli/pkg/exitcode"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	JSON = "json"
+	YAML = "yaml"
+)
+
+// Validate returns a validation error if format is not empty and not a supported format.
+func Validate(format string) error {
+	switch format {
+	case "", JSON, YAML:
+		return nil
+	}
+	return exitcode.Validationf("unsupported output format %q, must be one of: %s, %s", format, JSON, YAML)
+}
+
+// Print writes result to w in the given format. Nothing is written if format is empty.
+func Print(w io.Writer, format string, result interface{}) error {
+	var (
+		data []byte
+		err  error
+	)
+	switch format {
+	case "":
+		return nil
+	case JSON:
+		data, err = json.MarshalIndent(result, "", "  ")
+		data = append(data, '\n')
+	case YAML:
+		data, err = yaml.Marshal(result)
+	default:
+		return Validate(format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
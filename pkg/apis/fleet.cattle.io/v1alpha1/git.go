@@ -8,6 +8,7 @@ import (
 var (
 	RepoLabel            = "fleet.cattle.io/repo-name"
 	BundleNamespaceLabel = "fleet.cattle.io/bundle-namespace"
+	CommitLabel          = "fleet.cattle.io/commit"
 )
 
 // +genclient
@@ -0,0 +1,77 @@
+package bundlereader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+
+	"sigs.k8s.io/yaml"
+)
+
+// chartMetadata is the part of a Chart.yaml needed to find local dependencies
+type chartMetadata struct {
+	Dependencies []struct {
+		Repository string `json:"repository,omitempty"`
+	} `json:"dependencies,omitempty"`
+}
+
+// ReadLocalDependencies parses the fleet.yaml in baseDir, without downloading
+// anything. It returns the bundle name from the fleet.yaml, if any, and the
+// local paths the bundle content is built from: baseDir itself, local helm
+// charts and their "file://" chart dependencies.
+func ReadLocalDependencies(baseDir string) (string, []string, error) {
+	fy := &fleetYAML{}
+	if file, err := setupIOReader(baseDir); err != nil {
+		return "", nil, err
+	} else if file != nil {
+		defer file.Close()
+		data, err := os.ReadFile(file.Name())
+		if err != nil {
+			return "", nil, err
+		}
+		if err := yaml.Unmarshal(data, fy); err != nil {
+			return "", nil, err
+		}
+	}
+
+	paths := []string{baseDir}
+	charts := []*fleet.HelmOptions{fy.Helm}
+	for _, target := range append(fy.Targets, fy.TargetCustomizations...) {
+		charts = append(charts, target.Helm)
+	}
+
+	for _, chart := range charts {
+		if chart == nil || chart.Chart == "" || chart.Repo != "" {
+			continue
+		}
+		chartDir := filepath.Join(baseDir, chart.Chart)
+		if _, err := os.Stat(chartDir); err != nil {
+			// not a local chart, e.g. an OCI or http URL
+			continue
+		}
+		paths = append(paths, chartDir)
+		paths = append(paths, chartFileDependencies(chartDir)...)
+	}
+
+	return fy.Name, paths, nil
+}
+
+// chartFileDependencies returns the paths of "file://" dependencies in a chart's Chart.yaml
+func chartFileDependencies(chartDir string) (result []string) {
+	data, err := os.ReadFile(filepath.Join(chartDir, "Chart.yaml"))
+	if err != nil {
+		return nil
+	}
+	meta := &chartMetadata{}
+	if err := yaml.Unmarshal(data, meta); err != nil {
+		return nil
+	}
+	for _, dep := range meta.Dependencies {
+		if strings.HasPrefix(dep.Repository, "file://") {
+			result = append(result, filepath.Join(chartDir, strings.TrimPrefix(dep.Repository, "file://")))
+		}
+	}
+	return result
+}